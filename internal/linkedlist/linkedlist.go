@@ -246,3 +246,211 @@ func (ll *LinkedList) Reverse() {
 	ll.Head = prev
 }
 
+// MoveRange relocates the nodes in the half-open range [from, to) so that
+// they start at index dest in the resulting list. Nodes are relinked in place
+// without allocation. A dest equal to from leaves the list unchanged.
+// Returns ErrIndexOutOfRange if the bounds are invalid or if dest falls
+// elsewhere inside [from, to).
+// Time complexity: O(n)
+func (ll *LinkedList) MoveRange(from, to, dest int) error {
+	if from < 0 || to > ll.size || from >= to {
+		return ErrIndexOutOfRange
+	}
+	
+	if dest == from {
+		return nil
+	}
+	
+	if dest > from && dest < to {
+		return ErrIndexOutOfRange
+	}
+	
+	count := to - from
+	if dest < 0 || dest > ll.size-count {
+		return ErrIndexOutOfRange
+	}
+	
+	// Detach the range, remembering the node preceding it.
+	var before *Node
+	first := ll.Head
+	for i := 0; i < from; i++ {
+		before = first
+		first = first.Next
+	}
+	
+	last := first
+	for i := 1; i < count; i++ {
+		last = last.Next
+	}
+	
+	if before == nil {
+		ll.Head = last.Next
+	} else {
+		before.Next = last.Next
+	}
+	if last == ll.Tail {
+		ll.Tail = before
+	}
+	last.Next = nil
+	
+	// Splice the range back in so that it starts at dest.
+	if dest == 0 {
+		last.Next = ll.Head
+		ll.Head = first
+		if ll.Tail == nil {
+			ll.Tail = last
+		}
+		return nil
+	}
+	
+	prev := ll.Head
+	for i := 0; i < dest-1; i++ {
+		prev = prev.Next
+	}
+	
+	last.Next = prev.Next
+	prev.Next = first
+	if prev == ll.Tail {
+		ll.Tail = last
+	}
+	
+	return nil
+}
+
+// ReverseFirstK reverses the first k nodes of the list in place, leaving the
+// remaining nodes untouched. A k of 0 or 1 leaves the list unchanged.
+// Returns ErrIndexOutOfRange if k is negative or greater than the list size.
+// Time complexity: O(k)
+func (ll *LinkedList) ReverseFirstK(k int) error {
+	if k < 0 || k > ll.size {
+		return ErrIndexOutOfRange
+	}
+	
+	if k <= 1 {
+		return nil
+	}
+	
+	var prev *Node
+	current := ll.Head
+	
+	for i := 0; i < k; i++ {
+		next := current.Next
+		current.Next = prev
+		prev = current
+		current = next
+	}
+	
+	// The old head is now the last reversed node; reattach the rest.
+	ll.Head.Next = current
+	if current == nil {
+		ll.Tail = ll.Head
+	}
+	ll.Head = prev
+	
+	return nil
+}
+
+// SameMultiset reports whether both lists contain the same values with the
+// same multiplicities, regardless of order.
+// Time complexity: O(n)
+func (ll *LinkedList) SameMultiset(other *LinkedList) bool {
+	if ll.size != other.size {
+		return false
+	}
+	
+	counts := make(map[int]int, ll.size)
+	for current := ll.Head; current != nil; current = current.Next {
+		counts[current.Value]++
+	}
+	
+	for current := other.Head; current != nil; current = current.Next {
+		if counts[current.Value] == 0 {
+			return false
+		}
+		counts[current.Value]--
+	}
+	
+	return true
+}
+
+// PrefixSums returns a new list where each element is the running sum of the
+// original elements up to and including that position. The original list is
+// left unchanged.
+// Time complexity: O(n)
+func (ll *LinkedList) PrefixSums() *LinkedList {
+	result := New()
+	
+	sum := 0
+	for current := ll.Head; current != nil; current = current.Next {
+		sum += current.Value
+		result.Append(sum)
+	}
+	
+	return result
+}
+
+// MakeHead rotates the list, preserving relative order, so that the first
+// node with the given value becomes the head.
+// Returns false if the value is not present.
+// Time complexity: O(n)
+func (ll *LinkedList) MakeHead(value int) bool {
+	var prev *Node
+	current := ll.Head
+	for current != nil && current.Value != value {
+		prev = current
+		current = current.Next
+	}
+	
+	if current == nil {
+		return false
+	}
+	
+	if prev == nil {
+		return true
+	}
+	
+	ll.Tail.Next = ll.Head
+	ll.Head = current
+	ll.Tail = prev
+	prev.Next = nil
+	
+	return true
+}
+
+// RemoveNthFromEnd removes the node n positions from the end of the list,
+// so n=1 removes the tail. It walks the list once, keeping a gap of n nodes
+// between two pointers.
+// Returns ErrIndexOutOfRange if n is less than 1 or greater than the list size.
+// Time complexity: O(n)
+func (ll *LinkedList) RemoveNthFromEnd(n int) error {
+	if n < 1 || n > ll.size {
+		return ErrIndexOutOfRange
+	}
+	
+	lead := ll.Head
+	for i := 0; i < n; i++ {
+		lead = lead.Next
+	}
+	
+	// prev trails the node to remove; nil means the head is removed.
+	var prev *Node
+	current := ll.Head
+	for lead != nil {
+		prev = current
+		current = current.Next
+		lead = lead.Next
+	}
+	
+	if prev == nil {
+		ll.Head = current.Next
+	} else {
+		prev.Next = current.Next
+	}
+	
+	if current == ll.Tail {
+		ll.Tail = prev
+	}
+	ll.size--
+	
+	return nil
+}
@@ -448,6 +448,424 @@ func TestLinkedList_Clear(t *testing.T) {
 	}
 }
 
+func TestLinkedList_MoveRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int
+		from      int
+		to        int
+		dest      int
+		want      []int
+		wantError bool
+	}{
+		{
+			name:      "move middle range to front",
+			initial:   []int{1, 2, 3, 4, 5},
+			from:      2,
+			to:        4,
+			dest:      0,
+			want:      []int{3, 4, 1, 2, 5},
+			wantError: false,
+		},
+		{
+			name:      "move middle range to end",
+			initial:   []int{1, 2, 3, 4, 5},
+			from:      1,
+			to:        3,
+			dest:      3,
+			want:      []int{1, 4, 5, 2, 3},
+			wantError: false,
+		},
+		{
+			name:      "move tail range to front",
+			initial:   []int{1, 2, 3, 4, 5},
+			from:      3,
+			to:        5,
+			dest:      0,
+			want:      []int{4, 5, 1, 2, 3},
+			wantError: false,
+		},
+		{
+			name:      "dest equal to from is a no-op",
+			initial:   []int{1, 2, 3, 4, 5},
+			from:      1,
+			to:        4,
+			dest:      1,
+			want:      []int{1, 2, 3, 4, 5},
+			wantError: false,
+		},
+		{
+			name:      "dest inside range",
+			initial:   []int{1, 2, 3, 4, 5},
+			from:      1,
+			to:        4,
+			dest:      2,
+			want:      []int{1, 2, 3, 4, 5},
+			wantError: true,
+		},
+		{
+			name:      "dest inside range past size minus count",
+			initial:   []int{1, 2, 3, 4, 5},
+			from:      2,
+			to:        5,
+			dest:      3,
+			want:      []int{1, 2, 3, 4, 5},
+			wantError: true,
+		},
+		{
+			name:      "empty range",
+			initial:   []int{1, 2, 3},
+			from:      1,
+			to:        1,
+			dest:      0,
+			want:      []int{1, 2, 3},
+			wantError: true,
+		},
+		{
+			name:      "to out of range",
+			initial:   []int{1, 2, 3},
+			from:      1,
+			to:        5,
+			dest:      0,
+			want:      []int{1, 2, 3},
+			wantError: true,
+		},
+		{
+			name:      "dest out of range",
+			initial:   []int{1, 2, 3, 4},
+			from:      0,
+			to:        2,
+			dest:      3,
+			want:      []int{1, 2, 3, 4},
+			wantError: true,
+		},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := createList(tt.initial)
+			err := ll.MoveRange(tt.from, tt.to, tt.dest)
+			
+			if (err != nil) != tt.wantError {
+				t.Errorf("MoveRange() error = %v, wantError %v", err, tt.wantError)
+				return
+			}
+			
+			got := ll.ToSlice()
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			
+			if ll.Tail.Value != tt.want[len(tt.want)-1] {
+				t.Errorf("Tail = %d, want %d", ll.Tail.Value, tt.want[len(tt.want)-1])
+			}
+		})
+	}
+}
+
+func TestLinkedList_ReverseFirstK(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int
+		k         int
+		want      []int
+		wantError bool
+	}{
+		{
+			name:      "reverse whole list",
+			initial:   []int{1, 2, 3, 4, 5},
+			k:         5,
+			want:      []int{5, 4, 3, 2, 1},
+			wantError: false,
+		},
+		{
+			name:      "reverse first three",
+			initial:   []int{1, 2, 3, 4, 5},
+			k:         3,
+			want:      []int{3, 2, 1, 4, 5},
+			wantError: false,
+		},
+		{
+			name:      "k of one is a no-op",
+			initial:   []int{1, 2, 3},
+			k:         1,
+			want:      []int{1, 2, 3},
+			wantError: false,
+		},
+		{
+			name:      "k greater than size",
+			initial:   []int{1, 2, 3},
+			k:         4,
+			want:      []int{1, 2, 3},
+			wantError: true,
+		},
+		{
+			name:      "negative k",
+			initial:   []int{1, 2, 3},
+			k:         -1,
+			want:      []int{1, 2, 3},
+			wantError: true,
+		},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := createList(tt.initial)
+			err := ll.ReverseFirstK(tt.k)
+			
+			if (err != nil) != tt.wantError {
+				t.Errorf("ReverseFirstK() error = %v, wantError %v", err, tt.wantError)
+				return
+			}
+			
+			got := ll.ToSlice()
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			
+			if ll.Tail.Value != tt.want[len(tt.want)-1] {
+				t.Errorf("Tail = %d, want %d", ll.Tail.Value, tt.want[len(tt.want)-1])
+			}
+		})
+	}
+}
+
+func TestLinkedList_SameMultiset(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int
+		b    []int
+		want bool
+	}{
+		{
+			name: "both empty",
+			a:    []int{},
+			b:    []int{},
+			want: true,
+		},
+		{
+			name: "reordered but equal",
+			a:    []int{1, 2, 2, 3},
+			b:    []int{2, 3, 1, 2},
+			want: true,
+		},
+		{
+			name: "differ by one duplicate",
+			a:    []int{1, 2, 2, 3},
+			b:    []int{1, 2, 3, 3},
+			want: false,
+		},
+		{
+			name: "different lengths",
+			a:    []int{1, 2, 3},
+			b:    []int{1, 2, 3, 3},
+			want: false,
+		},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := createList(tt.a)
+			b := createList(tt.b)
+			
+			if got := a.SameMultiset(b); got != tt.want {
+				t.Errorf("SameMultiset() = %v, want %v", got, tt.want)
+			}
+			if got := b.SameMultiset(a); got != tt.want {
+				t.Errorf("reversed SameMultiset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinkedList_PrefixSums(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int
+		want    []int
+	}{
+		{
+			name:    "empty list",
+			initial: []int{},
+			want:    []int{},
+		},
+		{
+			name:    "positive values",
+			initial: []int{1, 2, 3},
+			want:    []int{1, 3, 6},
+		},
+		{
+			name:    "mixed signs",
+			initial: []int{5, -3, 4, -10},
+			want:    []int{5, 2, 6, -4},
+		},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := createList(tt.initial)
+			sums := ll.PrefixSums()
+			
+			got := sums.ToSlice()
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			
+			if !slicesEqual(ll.ToSlice(), tt.initial) {
+				t.Errorf("original modified: got %v, want %v", ll.ToSlice(), tt.initial)
+			}
+		})
+	}
+}
+
+func TestLinkedList_MakeHead(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int
+		value     int
+		want      []int
+		wantFound bool
+	}{
+		{
+			name:      "tail becomes head",
+			initial:   []int{1, 2, 3, 4},
+			value:     4,
+			want:      []int{4, 1, 2, 3},
+			wantFound: true,
+		},
+		{
+			name:      "middle node becomes head",
+			initial:   []int{1, 2, 3, 4},
+			value:     3,
+			want:      []int{3, 4, 1, 2},
+			wantFound: true,
+		},
+		{
+			name:      "current head is a no-op",
+			initial:   []int{1, 2, 3, 4},
+			value:     1,
+			want:      []int{1, 2, 3, 4},
+			wantFound: true,
+		},
+		{
+			name:      "missing value",
+			initial:   []int{1, 2, 3, 4},
+			value:     99,
+			want:      []int{1, 2, 3, 4},
+			wantFound: false,
+		},
+		{
+			name:      "empty list",
+			initial:   []int{},
+			value:     1,
+			want:      []int{},
+			wantFound: false,
+		},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := createList(tt.initial)
+			found := ll.MakeHead(tt.value)
+			
+			if found != tt.wantFound {
+				t.Errorf("MakeHead() = %v, want %v", found, tt.wantFound)
+			}
+			
+			got := ll.ToSlice()
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			
+			if len(tt.want) > 0 && ll.Tail.Value != tt.want[len(tt.want)-1] {
+				t.Errorf("Tail = %d, want %d", ll.Tail.Value, tt.want[len(tt.want)-1])
+			}
+		})
+	}
+}
+
+func TestLinkedList_RemoveNthFromEnd(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int
+		n         int
+		want      []int
+		wantError bool
+	}{
+		{
+			name:      "remove head",
+			initial:   []int{1, 2, 3, 4},
+			n:         4,
+			want:      []int{2, 3, 4},
+			wantError: false,
+		},
+		{
+			name:      "remove tail",
+			initial:   []int{1, 2, 3, 4},
+			n:         1,
+			want:      []int{1, 2, 3},
+			wantError: false,
+		},
+		{
+			name:      "remove middle",
+			initial:   []int{1, 2, 3, 4},
+			n:         2,
+			want:      []int{1, 2, 4},
+			wantError: false,
+		},
+		{
+			name:      "remove only node",
+			initial:   []int{1},
+			n:         1,
+			want:      []int{},
+			wantError: false,
+		},
+		{
+			name:      "n greater than size",
+			initial:   []int{1, 2, 3},
+			n:         4,
+			want:      []int{1, 2, 3},
+			wantError: true,
+		},
+		{
+			name:      "n of zero",
+			initial:   []int{1, 2, 3},
+			n:         0,
+			want:      []int{1, 2, 3},
+			wantError: true,
+		},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := createList(tt.initial)
+			err := ll.RemoveNthFromEnd(tt.n)
+			
+			if (err != nil) != tt.wantError {
+				t.Errorf("RemoveNthFromEnd() error = %v, wantError %v", err, tt.wantError)
+				return
+			}
+			
+			got := ll.ToSlice()
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			
+			if ll.Size() != len(tt.want) {
+				t.Errorf("size = %d, want %d", ll.Size(), len(tt.want))
+			}
+			
+			if len(tt.want) == 0 {
+				if ll.Head != nil || ll.Tail != nil {
+					t.Error("Head and Tail should be nil for an empty list")
+				}
+			} else if ll.Tail.Value != tt.want[len(tt.want)-1] {
+				t.Errorf("Tail = %d, want %d", ll.Tail.Value, tt.want[len(tt.want)-1])
+			}
+		})
+	}
+}
+
 // Helper functions
 
 func createList(values []int) *LinkedList {
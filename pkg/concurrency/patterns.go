@@ -2,31 +2,58 @@ package concurrency
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 )
 
+// ErrJobNotRun is reported by CloseAndCollect for submitted jobs that were
+// never processed, for example because the context was cancelled.
+var ErrJobNotRun = errors.New("job not run")
+
 // Worker represents a worker function that processes data.
 type Worker func(id int, data interface{}) error
 
+// indexedJob pairs submitted data with its submission order.
+type indexedJob struct {
+	index int
+	data  interface{}
+}
+
 // WorkerPool manages a pool of goroutines for concurrent task processing.
 type WorkerPool struct {
-	workers int
-	jobs    chan interface{}
-	results chan error
-	wg      sync.WaitGroup
+	workers   int
+	jobs      chan indexedJob
+	results   chan error
+	progress  chan float64
+	mu        sync.Mutex
+	submitted int
+	completed int
+	collect   bool
+	jobErrs   []error
+	wg        sync.WaitGroup
 }
 
 // NewWorkerPool creates a new worker pool with the specified number of workers.
 func NewWorkerPool(workers int) *WorkerPool {
 	return &WorkerPool{
-		workers: workers,
-		jobs:    make(chan interface{}, workers*2),
-		results: make(chan error, workers*2),
+		workers:  workers,
+		jobs:     make(chan indexedJob, workers*2),
+		results:  make(chan error, workers*2),
+		progress: make(chan float64, workers*2),
 	}
 }
 
+// NewCollectingWorkerPool creates a worker pool that records the error of
+// every submitted job for CloseAndCollect. Memory grows with the number of
+// submitted jobs, so it is intended for bounded batches.
+func NewCollectingWorkerPool(workers int) *WorkerPool {
+	wp := NewWorkerPool(workers)
+	wp.collect = true
+	return wp
+}
+
 // Start begins processing jobs with the given worker function.
 // The context can be used to cancel all workers.
 func (wp *WorkerPool) Start(ctx context.Context, worker Worker) {
@@ -36,6 +63,19 @@ func (wp *WorkerPool) Start(ctx context.Context, worker Worker) {
 	}
 }
 
+// StartWithState begins processing jobs, giving each worker its own state
+// created once by newState. The state is passed to every job that worker
+// handles, so it can be reused without locking.
+func (wp *WorkerPool) StartWithState(ctx context.Context, newState func(id int) interface{}, worker func(id int, state, data interface{}) error) {
+	for i := 0; i < wp.workers; i++ {
+		state := newState(i)
+		wp.wg.Add(1)
+		go wp.runWorker(ctx, i, func(id int, data interface{}) error {
+			return worker(id, state, data)
+		})
+	}
+}
+
 // runWorker processes jobs from the jobs channel until context is cancelled or channel is closed.
 func (wp *WorkerPool) runWorker(ctx context.Context, id int, worker Worker) {
 	defer wp.wg.Done()
@@ -48,15 +88,48 @@ func (wp *WorkerPool) runWorker(ctx context.Context, id int, worker Worker) {
 			if !ok {
 				return
 			}
-			err := worker(id, job)
+			err := worker(id, job.data)
+			wp.recordResult(job.index, err)
 			wp.results <- err
 		}
 	}
 }
 
+// recordResult stores the error of a finished job and emits the completed
+// fraction. If the progress channel is full, the oldest update is dropped so
+// that a slow reader never blocks the workers.
+func (wp *WorkerPool) recordResult(index int, err error) {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	
+	if wp.collect {
+		wp.jobErrs[index] = err
+	}
+	wp.completed++
+	fraction := float64(wp.completed) / float64(wp.submitted)
+	
+	select {
+	case wp.progress <- fraction:
+	default:
+		select {
+		case <-wp.progress:
+		default:
+		}
+		wp.progress <- fraction
+	}
+}
+
 // Submit adds a new job to the worker pool.
 func (wp *WorkerPool) Submit(job interface{}) {
-	wp.jobs <- job
+	wp.mu.Lock()
+	index := wp.submitted
+	wp.submitted++
+	if wp.collect {
+		wp.jobErrs = append(wp.jobErrs, ErrJobNotRun)
+	}
+	wp.mu.Unlock()
+	
+	wp.jobs <- indexedJob{index: index, data: job}
 }
 
 // Close closes the jobs channel and waits for all workers to finish.
@@ -64,6 +137,33 @@ func (wp *WorkerPool) Close() {
 	close(wp.jobs)
 	wp.wg.Wait()
 	close(wp.results)
+	close(wp.progress)
+}
+
+// CloseAndCollect closes the pool, waits for all workers to finish and returns
+// the error of every submitted job in submission order, so position i holds
+// the result of the i-th call to Submit. Jobs that never ran report
+// ErrJobNotRun. It drains the Results channel while waiting.
+// Errors are only recorded by pools created with NewCollectingWorkerPool;
+// for other pools the returned slice is nil.
+func (wp *WorkerPool) CloseAndCollect() []error {
+	go func() {
+		for range wp.results {
+		}
+	}()
+	
+	wp.Close()
+	
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	
+	if !wp.collect {
+		return nil
+	}
+	
+	errs := make([]error, len(wp.jobErrs))
+	copy(errs, wp.jobErrs)
+	return errs
 }
 
 // Results returns the results channel.
@@ -71,6 +171,15 @@ func (wp *WorkerPool) Results() <-chan error {
 	return wp.results
 }
 
+// Progress returns a channel that receives the fraction of submitted jobs
+// completed so far (completed/submitted) each time a job finishes.
+// The fraction may decrease if more jobs are submitted after some complete.
+// When the channel is full the oldest update is dropped, so slow consumers
+// only see the most recent values. The channel is closed by Close.
+func (wp *WorkerPool) Progress() <-chan float64 {
+	return wp.progress
+}
+
 // Pipeline demonstrates a pipeline pattern with multiple stages.
 type Pipeline struct {
 	stages []func(context.Context, <-chan interface{}) <-chan interface{}
@@ -90,6 +199,14 @@ func (p *Pipeline) Execute(ctx context.Context, input <-chan interface{}) <-chan
 	return out
 }
 
+// Chain composes two pipeline stages whose element types may differ,
+// returning a single stage that feeds the output of s1 into s2.
+func Chain[A, B, C any](s1 func(context.Context, <-chan A) <-chan B, s2 func(context.Context, <-chan B) <-chan C) func(context.Context, <-chan A) <-chan C {
+	return func(ctx context.Context, input <-chan A) <-chan C {
+		return s2(ctx, s1(ctx, input))
+	}
+}
+
 // FanOut distributes work from a single channel to multiple workers.
 // Returns a slice of output channels, one per worker.
 func FanOut(ctx context.Context, input <-chan interface{}, workers int, fn func(interface{}) interface{}) []<-chan interface{} {
@@ -139,6 +256,62 @@ func FanIn(ctx context.Context, inputs ...<-chan interface{}) <-chan interface{}
 	return output
 }
 
+// Result carries either a value or an error produced by a stage.
+type Result struct {
+	Value interface{}
+	Err   error
+}
+
+// FanInResults combines multiple Result channels, routing values and errors
+// to separate output channels as they arrive. Both outputs are closed once all
+// inputs are closed or the context is cancelled. Callers must drain both
+// channels concurrently.
+func FanInResults(ctx context.Context, inputs ...<-chan Result) (<-chan interface{}, <-chan error) {
+	var wg sync.WaitGroup
+	values := make(chan interface{})
+	errs := make(chan error)
+	
+	multiplex := func(c <-chan Result) {
+		defer wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case res, ok := <-c:
+				if !ok {
+					return
+				}
+				if res.Err != nil {
+					select {
+					case errs <- res.Err:
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
+				select {
+				case values <- res.Value:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+	
+	wg.Add(len(inputs))
+	for _, c := range inputs {
+		go multiplex(c)
+	}
+	
+	go func() {
+		wg.Wait()
+		close(values)
+		close(errs)
+	}()
+	
+	return values, errs
+}
+
 // worker is a helper function that processes data from input channel.
 func worker(ctx context.Context, input <-chan interface{}, fn func(interface{}) interface{}) <-chan interface{} {
 	output := make(chan interface{})
@@ -208,7 +381,12 @@ func (rl *RateLimiter) refill() {
 }
 
 // Wait blocks until a token is available or context is cancelled.
+// An already cancelled context takes priority over an available token.
 func (rl *RateLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -225,34 +403,61 @@ func (rl *RateLimiter) Stop() {
 // Broadcast sends a message to multiple subscribers.
 type Broadcast struct {
 	mu          sync.RWMutex
-	subscribers map[string]chan interface{}
+	subscribers map[string]*subscriber
+}
+
+// subscriber holds a subscriber's channel along with the state used to stop
+// in-flight SendAsync deliveries before the channel is closed.
+type subscriber struct {
+	ch      chan interface{}
+	quit    chan struct{}
+	pending sync.WaitGroup
+}
+
+// stop signals in-flight SendAsync deliveries to give up, waits for them
+// and closes the channel. The subscriber must already be removed from the map.
+func (s *subscriber) stop() {
+	close(s.quit)
+	s.pending.Wait()
+	close(s.ch)
 }
 
 // NewBroadcast creates a new broadcast instance.
 func NewBroadcast() *Broadcast {
 	return &Broadcast{
-		subscribers: make(map[string]chan interface{}),
+		subscribers: make(map[string]*subscriber),
 	}
 }
 
 // Subscribe adds a new subscriber with the given ID.
+// An existing subscriber with the same ID is replaced and its channel closed.
 func (b *Broadcast) Subscribe(id string, bufferSize int) <-chan interface{} {
+	sub := &subscriber{
+		ch:   make(chan interface{}, bufferSize),
+		quit: make(chan struct{}),
+	}
+	
 	b.mu.Lock()
-	defer b.mu.Unlock()
+	old, replaced := b.subscribers[id]
+	b.subscribers[id] = sub
+	b.mu.Unlock()
 	
-	ch := make(chan interface{}, bufferSize)
-	b.subscribers[id] = ch
-	return ch
+	if replaced {
+		old.stop()
+	}
+	return sub.ch
 }
 
-// Unsubscribe removes a subscriber.
+// Unsubscribe removes a subscriber and closes its channel once any
+// in-flight SendAsync deliveries to it have been abandoned.
 func (b *Broadcast) Unsubscribe(id string) {
 	b.mu.Lock()
-	defer b.mu.Unlock()
+	sub, ok := b.subscribers[id]
+	delete(b.subscribers, id)
+	b.mu.Unlock()
 	
-	if ch, ok := b.subscribers[id]; ok {
-		close(ch)
-		delete(b.subscribers, id)
+	if ok {
+		sub.stop()
 	}
 }
 
@@ -261,11 +466,11 @@ func (b *Broadcast) Send(ctx context.Context, msg interface{}) error {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 	
-	for _, ch := range b.subscribers {
+	for _, sub := range b.subscribers {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case ch <- msg:
+		case sub.ch <- msg:
 		default:
 			return fmt.Errorf("subscriber channel full")
 		}
@@ -274,14 +479,40 @@ func (b *Broadcast) Send(ctx context.Context, msg interface{}) error {
 	return nil
 }
 
+// SendAsync delivers a message to every subscriber in the background, one
+// goroutine per subscriber. Unlike Send, it waits for each subscriber to
+// accept the message, giving up when the context is cancelled or the
+// subscriber is removed. No lock is held while waiting, so a slow subscriber
+// does not block other calls or other subscribers.
+// Messages from successive calls may reach a subscriber in any order, and a
+// blocked subscriber holds one goroutine per pending message.
+func (b *Broadcast) SendAsync(ctx context.Context, msg interface{}) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	
+	for _, sub := range b.subscribers {
+		sub.pending.Add(1)
+		go func(sub *subscriber) {
+			defer sub.pending.Done()
+			select {
+			case <-ctx.Done():
+			case <-sub.quit:
+			case sub.ch <- msg:
+			}
+		}(sub)
+	}
+}
+
 // Close closes all subscriber channels.
+// In-flight SendAsync deliveries are stopped first, so no message is ever
+// sent on a closed channel.
 func (b *Broadcast) Close() {
 	b.mu.Lock()
-	defer b.mu.Unlock()
+	subs := b.subscribers
+	b.subscribers = make(map[string]*subscriber)
+	b.mu.Unlock()
 	
-	for _, ch := range b.subscribers {
-		close(ch)
+	for _, sub := range subs {
+		sub.stop()
 	}
-	b.subscribers = make(map[string]chan interface{})
 }
-
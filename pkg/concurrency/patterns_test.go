@@ -3,6 +3,8 @@ package concurrency
 import (
 	"context"
 	"errors"
+	"fmt"
+	"runtime"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -23,6 +25,11 @@ func TestWorkerPool(t *testing.T) {
 	
 	wp.Start(ctx, worker)
 	
+	go func() {
+		for range wp.Results() {
+		}
+	}()
+	
 	for i := 0; i < 10; i++ {
 		wp.Submit(i)
 	}
@@ -50,18 +57,23 @@ func TestWorkerPool_WithError(t *testing.T) {
 	
 	wp.Start(ctx, worker)
 	
+	errorCount := 0
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for err := range wp.Results() {
+			if err != nil {
+				errorCount++
+			}
+		}
+	}()
+	
 	for i := 0; i < 10; i++ {
 		wp.Submit(i)
 	}
 	
 	wp.Close()
-	
-	errorCount := 0
-	for err := range wp.Results() {
-		if err != nil {
-			errorCount++
-		}
-	}
+	<-done
 	
 	if errorCount != 1 {
 		t.Errorf("expected 1 error, got %d", errorCount)
@@ -97,6 +109,238 @@ func TestWorkerPool_ContextCancellation(t *testing.T) {
 	}
 }
 
+func TestWorkerPool_Progress(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	
+	wp := NewWorkerPool(3)
+	
+	worker := func(id int, data interface{}) error {
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	}
+	
+	wp.Start(ctx, worker)
+	
+	go func() {
+		for range wp.Results() {
+		}
+	}()
+	
+	for i := 0; i < 20; i++ {
+		wp.Submit(i)
+	}
+	
+	wp.Close()
+	
+	var last float64
+	for p := range wp.Progress() {
+		if p <= 0 || p > 1 {
+			t.Errorf("progress %v out of range (0, 1]", p)
+		}
+		last = p
+	}
+	
+	if last != 1.0 {
+		t.Errorf("expected final progress 1.0, got %v", last)
+	}
+}
+
+func TestWorkerPool_ProgressMovesBackward(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	
+	wp := NewWorkerPool(1)
+	
+	// Jobs of the second batch wait until both of them have been submitted.
+	release := make(chan struct{})
+	worker := func(id int, data interface{}) error {
+		if data.(int) >= 4 {
+			<-release
+		}
+		return nil
+	}
+	
+	wp.Start(ctx, worker)
+	
+	go func() {
+		for range wp.Results() {
+		}
+	}()
+	
+	for i := 0; i < 4; i++ {
+		wp.Submit(i)
+	}
+	
+	var earlier float64
+	for earlier < 1.0 {
+		select {
+		case earlier = <-wp.Progress():
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for first batch to complete")
+		}
+	}
+	
+	wp.Submit(4)
+	wp.Submit(5)
+	close(release)
+	
+	wp.Close()
+	
+	lowest := 1.0
+	var last float64
+	for p := range wp.Progress() {
+		if p < lowest {
+			lowest = p
+		}
+		last = p
+	}
+	
+	if lowest >= earlier {
+		t.Errorf("expected progress to drop below %v after more submissions, lowest was %v", earlier, lowest)
+	}
+	if last != 1.0 {
+		t.Errorf("expected final progress 1.0, got %v", last)
+	}
+}
+
+func TestWorkerPool_StartWithState(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	
+	const workers = 4
+	const jobs = 50
+	
+	wp := NewWorkerPool(workers)
+	
+	counters := make([]*int, workers)
+	newState := func(id int) interface{} {
+		counters[id] = new(int)
+		return counters[id]
+	}
+	
+	worker := func(id int, state, data interface{}) error {
+		*state.(*int)++
+		return nil
+	}
+	
+	wp.StartWithState(ctx, newState, worker)
+	
+	go func() {
+		for range wp.Results() {
+		}
+	}()
+	
+	for i := 0; i < jobs; i++ {
+		wp.Submit(i)
+	}
+	
+	wp.Close()
+	
+	total := 0
+	for _, c := range counters {
+		total += *c
+	}
+	
+	if total != jobs {
+		t.Errorf("expected per-worker totals to sum to %d, got %d", jobs, total)
+	}
+}
+
+func TestWorkerPool_CloseAndCollect(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	
+	wp := NewCollectingWorkerPool(3)
+	
+	expectedErr := errors.New("worker error")
+	worker := func(id int, data interface{}) error {
+		if data.(int) == 2 {
+			return expectedErr
+		}
+		time.Sleep(time.Duration(data.(int)%3) * time.Millisecond)
+		return nil
+	}
+	
+	wp.Start(ctx, worker)
+	
+	for i := 0; i < 10; i++ {
+		wp.Submit(i)
+	}
+	
+	result := wp.CloseAndCollect()
+	
+	if len(result) != 10 {
+		t.Fatalf("expected 10 results, got %d", len(result))
+	}
+	
+	for i, err := range result {
+		if i == 2 {
+			if !errors.Is(err, expectedErr) {
+				t.Errorf("result[2] = %v, want %v", err, expectedErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("result[%d] = %v, want nil", i, err)
+		}
+	}
+}
+
+func TestWorkerPool_CloseAndCollect_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	
+	wp := NewCollectingWorkerPool(2)
+	
+	expectedErr := errors.New("worker error")
+	worker := func(id int, data interface{}) error {
+		return expectedErr
+	}
+	
+	wp.Start(ctx, worker)
+	
+	for i := 0; i < 4; i++ {
+		wp.Submit(i)
+	}
+	
+	result := wp.CloseAndCollect()
+	
+	if len(result) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(result))
+	}
+	
+	for i, err := range result {
+		if !errors.Is(err, ErrJobNotRun) && !errors.Is(err, expectedErr) {
+			t.Errorf("result[%d] = %v, want %v or %v", i, err, ErrJobNotRun, expectedErr)
+		}
+	}
+}
+
+func TestWorkerPool_CloseAndCollect_NotCollecting(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	
+	wp := NewWorkerPool(2)
+	
+	worker := func(id int, data interface{}) error {
+		return nil
+	}
+	
+	wp.Start(ctx, worker)
+	
+	for i := 0; i < 4; i++ {
+		wp.Submit(i)
+	}
+	
+	if result := wp.CloseAndCollect(); result != nil {
+		t.Errorf("expected nil result for non-collecting pool, got %v", result)
+	}
+	if wp.jobErrs != nil {
+		t.Errorf("expected no per-job errors to be kept, got %d", len(wp.jobErrs))
+	}
+}
+
 func TestPipeline(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -153,6 +397,67 @@ func TestPipeline(t *testing.T) {
 	}
 }
 
+func TestChain(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	
+	double := func(ctx context.Context, input <-chan int) <-chan int {
+		output := make(chan int)
+		go func() {
+			defer close(output)
+			for val := range input {
+				select {
+				case <-ctx.Done():
+					return
+				case output <- val * 2:
+				}
+			}
+		}()
+		return output
+	}
+	
+	format := func(ctx context.Context, input <-chan int) <-chan string {
+		output := make(chan string)
+		go func() {
+			defer close(output)
+			for val := range input {
+				select {
+				case <-ctx.Done():
+					return
+				case output <- fmt.Sprintf("#%d", val):
+				}
+			}
+		}()
+		return output
+	}
+	
+	stage := Chain(double, format)
+	
+	input := make(chan int)
+	go func() {
+		defer close(input)
+		for i := 1; i <= 3; i++ {
+			input <- i
+		}
+	}()
+	
+	expected := []string{"#2", "#4", "#6"}
+	i := 0
+	for result := range stage(ctx, input) {
+		if i >= len(expected) {
+			t.Fatalf("unexpected extra output %q", result)
+		}
+		if result != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], result)
+		}
+		i++
+	}
+	
+	if i != len(expected) {
+		t.Errorf("expected %d outputs, got %d", len(expected), i)
+	}
+}
+
 func TestFanOutFanIn(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -184,6 +489,63 @@ func TestFanOutFanIn(t *testing.T) {
 	}
 }
 
+func TestFanInResults(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	
+	errBad := errors.New("bad input")
+	produce := func(results ...Result) <-chan Result {
+		ch := make(chan Result)
+		go func() {
+			defer close(ch)
+			for _, r := range results {
+				ch <- r
+			}
+		}()
+		return ch
+	}
+	
+	in1 := produce(Result{Value: 1}, Result{Err: errBad}, Result{Value: 2})
+	in2 := produce(Result{Err: errBad}, Result{Value: 3})
+	in3 := produce(Result{Value: 4})
+	
+	values, errs := FanInResults(ctx, in1, in2, in3)
+	
+	sum := 0
+	valueCount := 0
+	errorCount := 0
+	for values != nil || errs != nil {
+		select {
+		case val, ok := <-values:
+			if !ok {
+				values = nil
+				continue
+			}
+			sum += val.(int)
+			valueCount++
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			if !errors.Is(err, errBad) {
+				t.Errorf("unexpected error %v", err)
+			}
+			errorCount++
+		}
+	}
+	
+	if valueCount != 4 {
+		t.Errorf("expected 4 values, got %d", valueCount)
+	}
+	if sum != 10 {
+		t.Errorf("expected sum 10, got %d", sum)
+	}
+	if errorCount != 2 {
+		t.Errorf("expected 2 errors, got %d", errorCount)
+	}
+}
+
 func TestRateLimiter(t *testing.T) {
 	ctx := context.Background()
 	rl := NewRateLimiter(5)
@@ -279,6 +641,124 @@ func TestBroadcast_Unsubscribe(t *testing.T) {
 	}
 }
 
+func TestBroadcast_CloseWaitsForSendAsync(t *testing.T) {
+	ctx := context.Background()
+	before := runtime.NumGoroutine()
+	
+	b := NewBroadcast()
+	sub1 := b.Subscribe("sub1", 1)
+	b.Subscribe("sub2", 1)
+	
+	for i := 0; i < 100; i++ {
+		b.SendAsync(ctx, i)
+	}
+	
+	b.Close()
+	
+	for range sub1 {
+	}
+	
+	// SendAsync after Close must be a no-op rather than a panic.
+	b.SendAsync(ctx, "late")
+	
+	deadline := time.Now().Add(1 * time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("leaked goroutines: before %d, after %d", before, after)
+	}
+}
+
+func TestBroadcast_ResubscribeReleasesSendAsync(t *testing.T) {
+	ctx := context.Background()
+	before := runtime.NumGoroutine()
+	
+	b := NewBroadcast()
+	old := b.Subscribe("a", 0)
+	
+	// Nobody reads from old, so this delivery stays in flight.
+	b.SendAsync(ctx, 1)
+	
+	b.Subscribe("a", 0)
+	
+	select {
+	case _, ok := <-old:
+		if ok {
+			t.Error("expected replaced channel to be closed")
+		}
+	case <-time.After(1 * time.Second):
+		t.Fatal("replaced channel was not closed")
+	}
+	
+	b.Close()
+	
+	deadline := time.Now().Add(1 * time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("leaked goroutines: before %d, after %d", before, after)
+	}
+}
+
+func TestBroadcast_SlowSubscriberDoesNotBlock(t *testing.T) {
+	ctx := context.Background()
+	b := NewBroadcast()
+	defer b.Close()
+	
+	slow := b.Subscribe("slow", 0)
+	fast := b.Subscribe("fast", 10)
+	
+	// Nobody reads from slow, so this delivery stays in flight.
+	b.SendAsync(ctx, "stuck")
+	time.Sleep(20 * time.Millisecond)
+	
+	completes := func(name string, fn func()) {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			fn()
+		}()
+		select {
+		case <-done:
+		case <-time.After(1 * time.Second):
+			t.Fatalf("%s blocked behind a pending SendAsync", name)
+		}
+	}
+	
+	completes("Send", func() {
+		b.Send(ctx, "probe")
+	})
+	completes("Unsubscribe", func() {
+		b.Unsubscribe("slow")
+	})
+	
+	if _, ok := <-slow; ok {
+		t.Error("expected slow channel to be closed after unsubscribe")
+	}
+	
+	completes("Send", func() {
+		if err := b.Send(ctx, "after"); err != nil {
+			t.Errorf("Send() error = %v", err)
+		}
+	})
+	
+	timeout := time.After(1 * time.Second)
+	for {
+		select {
+		case msg := <-fast:
+			if msg == "after" {
+				return
+			}
+		case <-timeout:
+			t.Fatal("fast subscriber did not receive message sent after unsubscribe")
+		}
+	}
+}
+
 // Helper functions
 
 func collectMessages(ch <-chan interface{}, count int) []interface{} {